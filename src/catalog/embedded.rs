@@ -33,6 +33,7 @@ fn load_harness(files: &BTreeMap<&str, &str>) -> io::Result<Harness> {
         env_mode: EnvMode::parse(&parser::string(&meta, "env_mode").map_err(invalid)?)
             .map_err(invalid)?,
         env: parser::list(&meta, "env").map_err(invalid)?,
+        env_aliases: parser::list(&meta, "env_aliases").map_err(invalid)?,
         capabilities,
     })
 }
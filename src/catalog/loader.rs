@@ -22,6 +22,19 @@ pub fn load(root: &Path) -> io::Result<Vec<Harness>> {
     Ok(harnesses)
 }
 
+pub fn load_error(root: &Path, error: io::Error) -> String {
+    if error.kind() == io::ErrorKind::NotFound {
+        return format!(
+            "harness catalog is missing at {}; reinstall terminal-jarvis or set TERMINAL_JARVIS_CATALOG",
+            root.display()
+        );
+    }
+    format!(
+        "failed to load harness catalog at {}: {error}",
+        root.display()
+    )
+}
+
 fn should_use_embedded(root: &Path) -> bool {
     !catalog_env_set() && root == Path::new("harnesses") && !root.is_dir()
 }
@@ -44,6 +57,7 @@ fn load_harness(dir: &Path) -> io::Result<Harness> {
         env_mode: EnvMode::parse(&parser::string(&meta, "env_mode").map_err(invalid)?)
             .map_err(invalid)?,
         env: parser::list(&meta, "env").map_err(invalid)?,
+        env_aliases: parser::list(&meta, "env_aliases").map_err(invalid)?,
         capabilities,
     })
 }
@@ -3,5 +3,5 @@ mod loader;
 pub(crate) mod parser;
 mod validate;
 
-pub use loader::load;
+pub use loader::{load, load_error};
 pub use validate::validate;
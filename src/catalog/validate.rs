@@ -18,6 +18,16 @@ pub fn validate(harnesses: &[Harness]) -> Vec<String> {
             errors.push(format!("{} has env vars with env_mode none", harness.name));
         }
         validate_env(&harness.name, &harness.env, &mut errors);
+        if let Some(alias) = harness
+            .env_aliases
+            .iter()
+            .find(|name| !harness.env.contains(name))
+        {
+            errors.push(format!(
+                "{} aliases {alias}, which is not in env",
+                harness.name
+            ));
+        }
         if !harness.has_all_capabilities() {
             errors.push(format!("{} is missing a core capability", harness.name));
         }
@@ -6,6 +6,7 @@ const VERSION: &str = env!("CARGO_PKG_VERSION");
 
 pub use super::cache::handle as cache;
 use super::compat_support::auth_status;
+use super::suggest;
 #[path = "compat_config.rs"]
 mod config_output;
 #[path = "compat_output.rs"]
@@ -61,7 +62,7 @@ fn auth_detail(name: &str, harnesses: &[Harness], note: &str) -> Result<String,
     let harness = harnesses
         .iter()
         .find(|harness| harness.name == name)
-        .ok_or_else(|| format!("unknown harness '{name}'"))?;
+        .ok_or_else(|| suggest::unknown(name, harnesses))?;
     Ok(output::auth_detail(harness, &auth_status(harness), note))
 }
 
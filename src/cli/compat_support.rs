@@ -4,7 +4,7 @@ use crate::security;
 pub fn auth_status(harness: &Harness) -> String {
     let missing = security::missing_env(harness);
     if missing.is_empty() {
-        return "ready".to_string();
+        return ready_status(harness);
     }
     match harness.env_mode {
         EnvMode::Any => format!("missing one of: {}", missing.join(", ")),
@@ -12,3 +12,14 @@ pub fn auth_status(harness: &Harness) -> String {
         EnvMode::None => "ready".to_string(),
     }
 }
+
+pub fn ready_status(harness: &Harness) -> String {
+    let conflicting = security::conflicting_env(harness);
+    if conflicting.is_empty() {
+        return "ready".to_string();
+    }
+    format!(
+        "ready, but {} differ; unset the stale one",
+        conflicting.join(", ")
+    )
+}
@@ -16,6 +16,7 @@ fn harness(name: &str) -> Harness {
         binary: name.to_string(),
         env_mode: EnvMode::None,
         env: vec![],
+        env_aliases: vec![],
         capabilities: Capability::ALL.iter().map(|c| cap(*c)).collect(),
     }
 }
@@ -54,3 +55,30 @@ fn config_routes() {
 fn legacy_message() {
     assert!(legacy("templates").contains("Legacy Command"));
 }
+
+#[test]
+fn auth_status_flags_differing_aliases_of_one_key() {
+    let _guard = crate::ENV_LOCK
+        .lock()
+        .unwrap_or_else(|error| error.into_inner());
+    let mut h = harness("gemini");
+    h.env_mode = EnvMode::Any;
+    h.env = vec![
+        "TJ_AUTH_PRIMARY".to_string(),
+        "TJ_AUTH_SECONDARY".to_string(),
+    ];
+    let providers = h.clone();
+    h.env_aliases = h.env.clone();
+    std::env::set_var("TJ_AUTH_PRIMARY", "key-one");
+    std::env::set_var("TJ_AUTH_SECONDARY", "key-one");
+    let same = auth_status(&h);
+    std::env::set_var("TJ_AUTH_SECONDARY", "key-two");
+    let status = auth_status(&h);
+    let unrelated = auth_status(&providers);
+    std::env::remove_var("TJ_AUTH_PRIMARY");
+    std::env::remove_var("TJ_AUTH_SECONDARY");
+    assert_eq!(same, "ready");
+    assert_eq!(unrelated, "ready");
+    assert!(status.contains("TJ_AUTH_PRIMARY, TJ_AUTH_SECONDARY differ"));
+    assert!(!status.contains("key-"));
+}
@@ -1,4 +1,5 @@
-use super::{args::Action, compat, experimental, gate_cmd, guard, output};
+use super::invoke::find;
+use super::{args::Action, compat, experimental, failure::Failure, gate_cmd, guard, output};
 use crate::context;
 use crate::contracts::{Capability, Harness};
 use std::path::Path;
@@ -8,10 +9,10 @@ pub fn dispatch(
     harnesses: &[Harness],
     catalog_root: &Path,
     home: &Path,
-) -> Result<(i32, String), String> {
-    match action {
+) -> Result<(i32, String), Failure> {
+    let outcome = match action {
         Action::List => Ok((0, output::list(harnesses))),
-        Action::Check => Ok((0, output::checks(harnesses))),
+        Action::Check => Ok((0, output::readiness(harnesses, home))),
         Action::Current => Ok((0, output::current(context::load(home).map_err(err)?))),
         Action::Use(name) => {
             find(harnesses, &name)?;
@@ -29,10 +30,16 @@ pub fn dispatch(
         Action::SelfUpdate { .. } => {
             unreachable!("self-update handled before catalog load in execute()")
         }
-        Action::Run(words) => guard::run(&words, harnesses, home),
-        Action::Direct { harness, extra } => guard::direct(&harness, &extra, harnesses, home),
-        Action::Install(name) => guard::capability(harnesses, &name, Capability::Download, home),
-        Action::Update(Some(name)) => guard::capability(harnesses, &name, Capability::Update, home),
+        Action::Run(words) => return guard::run(&words, harnesses, home),
+        Action::Direct { harness, extra } => {
+            return guard::direct(&harness, &extra, harnesses, home)
+        }
+        Action::Install(name) => {
+            return guard::capability(harnesses, &name, Capability::Download, home)
+        }
+        Action::Update(Some(name)) => {
+            return guard::capability(harnesses, &name, Capability::Update, home)
+        }
         Action::Update(None) => Ok((0, compat::update_summary(harnesses))),
         Action::Auth(words) => compat::auth(&words, harnesses).map(|body| (0, body)),
         Action::Config(words) => compat::config(
@@ -51,7 +58,8 @@ pub fn dispatch(
         Action::Legacy(command) => Ok((0, compat::legacy(&command))),
         Action::Help => Ok((0, output::help())),
         Action::Version { .. } => unreachable!("version is handled before catalog load"),
-    }
+    };
+    Ok(outcome?)
 }
 
 fn security(words: &[String], harnesses: &[Harness]) -> Result<(i32, String), String> {
@@ -83,13 +91,6 @@ fn selected_name(explicit: Option<String>, home: &Path) -> Result<String, String
     )
 }
 
-fn find<'a>(harnesses: &'a [Harness], name: &str) -> Result<&'a Harness, String> {
-    harnesses
-        .iter()
-        .find(|harness| harness.name == name)
-        .ok_or_else(|| format!("unknown harness '{name}'"))
-}
-
 fn err(error: impl std::fmt::Display) -> String {
     error.to_string()
 }
@@ -19,6 +19,7 @@ fn harness(name: &str) -> Harness {
         binary: name.to_string(),
         env_mode: EnvMode::None,
         env: vec![],
+        env_aliases: vec![],
         capabilities: Capability::ALL.iter().map(|c| cap(*c)).collect(),
     }
 }
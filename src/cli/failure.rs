@@ -0,0 +1,16 @@
+#[derive(Debug, Eq, PartialEq)]
+pub struct Failure {
+    pub code: i32,
+    pub message: String,
+    pub detail: String,
+}
+
+impl From<String> for Failure {
+    fn from(message: String) -> Self {
+        Self {
+            code: 2,
+            message,
+            detail: String::new(),
+        }
+    }
+}
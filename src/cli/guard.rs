@@ -1,9 +1,9 @@
-use super::{invoke, resolve};
+use super::{failure::Failure, invoke, resolve, suggest};
 use crate::contracts::{Capability, Harness};
 use crate::gates;
 use std::path::Path;
 
-pub fn run(words: &[String], harnesses: &[Harness], home: &Path) -> Result<(i32, String), String> {
+pub fn run(words: &[String], harnesses: &[Harness], home: &Path) -> Result<(i32, String), Failure> {
     let invocation = resolve::run(words, harnesses, home)?;
     gates::preflight(home)?;
     invoke::invocation(invocation, harnesses)
@@ -14,7 +14,7 @@ pub fn direct(
     extra: &[String],
     harnesses: &[Harness],
     home: &Path,
-) -> Result<(i32, String), String> {
+) -> Result<(i32, String), Failure> {
     let invocation = resolve::direct(name, extra, harnesses)?;
     gates::preflight(home)?;
     invoke::invocation(invocation, harnesses)
@@ -25,7 +25,7 @@ pub fn capability(
     name: &str,
     capability: Capability,
     home: &Path,
-) -> Result<(i32, String), String> {
+) -> Result<(i32, String), Failure> {
     known(harnesses, name)?;
     gates::preflight(home)?;
     invoke::capability(harnesses, name, capability, &[])
@@ -36,7 +36,7 @@ fn known(harnesses: &[Harness], name: &str) -> Result<(), String> {
         .iter()
         .any(|harness| harness.name == name)
         .then_some(())
-        .ok_or_else(|| format!("unknown harness '{name}'"))
+        .ok_or_else(|| suggest::unknown(name, harnesses))
 }
 
 #[cfg(test)]
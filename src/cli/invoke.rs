@@ -1,11 +1,14 @@
-use super::resolve;
+use super::{failure::Failure, resolve, suggest};
 use crate::contracts::{Capability, CommandPlan, Harness};
-use crate::runtime;
+use crate::runtime::{self, Exit};
+
+const STDERR_LINES: usize = 20;
+const STDERR_BYTES: usize = 4096;
 
 pub fn invocation(
     invocation: resolve::Invocation,
     harnesses: &[Harness],
-) -> Result<(i32, String), String> {
+) -> Result<(i32, String), Failure> {
     capability(
         harnesses,
         &invocation.harness,
@@ -19,43 +22,65 @@ pub fn capability(
     harness: &str,
     capability: Capability,
     extra: &[String],
-) -> Result<(i32, String), String> {
+) -> Result<(i32, String), Failure> {
     let plan = find(harnesses, harness)?
         .plan(capability)
         .ok_or_else(|| format!("{harness} lacks {capability}"))?;
-    runtime::run_command(plan, extra)
-        .map(|(code, output)| {
-            if code == 0 {
-                (0, output)
-            } else {
-                (
-                    code,
-                    diagnostic(harness, capability, &plan.command, code, &output),
-                )
-            }
-        })
-        .map_err(|error| command_error(harness, plan.command.command.as_str(), error))
+    let exit = runtime::run_command(plan, extra)
+        .map_err(|error| command_error(harness, plan.command.command.as_str(), error))?;
+    if exit.code != 0 {
+        return Err(diagnostic(harness, capability, &plan.command, &exit));
+    }
+    Ok((0, String::new()))
 }
 
 fn diagnostic(
     harness: &str,
     capability: Capability,
     command: &CommandPlan,
-    code: i32,
-    output: &str,
-) -> String {
-    let mut body = format!("harness '{harness}' capability '{capability}' failed with exit {code}\n  command: {}\n  stderr: {output}", command.render());
+    exit: &Exit,
+) -> Failure {
+    let output = exit.stderr.as_str();
+    let mut detail = excerpt(output, exit.stderr_len);
+    if !detail.is_empty() && !detail.ends_with('\n') {
+        detail.push('\n');
+    }
     if output.contains("pipefail") || output.contains("Illegal option") {
-        body.push_str("\n  hint: the script uses `set -o pipefail`, which `sh` (dash) does not support; set the harness command to `bash -c ...` in the registry.");
+        detail.push_str("hint: the script uses `set -o pipefail`, which `sh` (dash) does not support; set the harness command to `bash -c ...` in the registry.\n");
+    }
+    Failure {
+        code: exit.code,
+        message: format!(
+            "harness '{harness}' capability '{capability}' failed with exit {} (command: {})",
+            exit.code,
+            command.render()
+        ),
+        detail,
     }
-    body
 }
 
-fn find<'a>(harnesses: &'a [Harness], name: &str) -> Result<&'a Harness, String> {
+fn excerpt(output: &str, total: usize) -> String {
+    let lines = output.lines().collect::<Vec<_>>();
+    let start = lines.len().saturating_sub(STDERR_LINES);
+    let tail = lines[start..].join("\n");
+    let cut = (tail.len().saturating_sub(STDERR_BYTES)..tail.len())
+        .find(|index| tail.is_char_boundary(*index))
+        .unwrap_or(0);
+    if start == 0 && cut == 0 && total <= output.len() {
+        return output.to_string();
+    }
+    let shown = format!("{}\n", &tail[cut..]);
+    format!(
+        "(truncated: showing the last {} of {total} bytes)\n{shown}",
+        shown.len()
+    )
+}
+
+pub fn find<'a>(harnesses: &'a [Harness], name: &str) -> Result<&'a Harness, String> {
     harnesses
         .iter()
         .find(|harness| harness.name == name)
-        .ok_or_else(|| format!("unknown harness '{name}'"))
+        .ok_or_else(|| suggest::unknown(name, harnesses))
 }
 
 fn command_error(harness: &str, binary: &str, error: std::io::Error) -> String {
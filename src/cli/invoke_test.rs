@@ -1,6 +1,14 @@
 use super::*;
 use crate::contracts::{CapabilityPlan, EnvMode, Harness};
 
+fn exit(code: i32, stderr: &str) -> Exit {
+    Exit {
+        code,
+        stderr: stderr.to_string(),
+        stderr_len: stderr.len(),
+    }
+}
+
 fn fake_harness() -> Vec<Harness> {
     vec![Harness {
         name: "vibe".into(),
@@ -9,6 +17,7 @@ fn fake_harness() -> Vec<Harness> {
         binary: "sh".into(),
         env_mode: EnvMode::None,
         env: vec![],
+        env_aliases: vec![],
         capabilities: vec![CapabilityPlan {
             capability: Capability::Download,
             summary: "d".into(),
@@ -18,41 +27,70 @@ fn fake_harness() -> Vec<Harness> {
 }
 
 #[test]
-fn failing_command_diagnoses_harness_capability_and_exit() {
-    let (code, body) = capability(&fake_harness(), "vibe", Capability::Download, &[]).unwrap();
-    assert_eq!(code, 3);
-    assert!(body.contains("vibe"), "harness: {body}");
-    assert!(body.contains("download"), "capability: {body}");
-    assert!(body.contains("exit 3"), "command: {body}");
-    assert!(body.contains('3'), "exit code: {body}");
+fn failing_command_returns_its_exit_code_as_a_failure() {
+    let failure = capability(&fake_harness(), "vibe", Capability::Download, &[]).unwrap_err();
+    assert_eq!(failure.code, 3);
+    assert_eq!(failure.detail, "");
 }
 
-fn pipefail_harness() -> Vec<Harness> {
-    vec![Harness {
-        name: "vibe".into(),
-        display: "Vibe".into(),
-        description: "t".into(),
-        binary: "sh".into(),
-        env_mode: EnvMode::None,
-        env: vec![],
-        capabilities: vec![CapabilityPlan {
-            capability: Capability::Download,
-            summary: "d".into(),
-            command: CommandPlan::new(
-                "sh".into(),
-                vec!["-c".into(), "echo pipefail >&2; exit 3".into()],
-            ),
-        }],
-    }]
+#[test]
+fn diagnostic_status_is_one_line_naming_harness_capability_and_exit() {
+    let command = CommandPlan::new("sh".into(), vec!["-c".into(), "exit 3".into()]);
+    let failure = diagnostic("vibe", Capability::Download, &command, &exit(3, "boom"));
+    assert_eq!(
+        failure.message,
+        "harness 'vibe' capability 'download' failed with exit 3 (command: sh -c 'exit 3')"
+    );
+    assert_eq!(failure.detail, "boom\n");
 }
 
 #[test]
-fn failing_command_appends_pipefail_hint() {
-    let (code, body) = capability(&pipefail_harness(), "vibe", Capability::Download, &[]).unwrap();
-    assert_eq!(code, 3);
-    assert!(body.contains("pipefail"), "stderr not surfaced: {body}");
+fn diagnostic_appends_pipefail_hint_to_the_detail() {
+    let command = CommandPlan::new("sh".into(), vec!["-c".into(), "exit 3".into()]);
+    let stderr = format!("{}pipefail\n", "noise\n".repeat(30));
+    let failure = diagnostic("vibe", Capability::Download, &command, &exit(3, &stderr));
     assert!(
-        body.contains("bash -c"),
-        "pipefail hint not appended: {body}"
+        failure.detail.contains("\npipefail\nhint: "),
+        "{}",
+        failure.detail
+    );
+    assert!(!failure.detail.contains("\n\n"), "{}", failure.detail);
+    assert!(failure.detail.contains("bash -c"), "{}", failure.detail);
+    assert!(!failure.message.contains('\n'));
+}
+
+#[test]
+fn short_stderr_is_shown_verbatim() {
+    assert_eq!(excerpt("boom\n", 5), "boom\n");
+    assert_eq!(excerpt("", 0), "");
+}
+
+#[test]
+fn long_stderr_keeps_only_the_last_lines() {
+    let output = (1..=500).map(|n| format!("line {n}\n")).collect::<String>();
+    let shown = excerpt(&output, output.len());
+    let marker = format!(
+        "(truncated: showing the last 180 of {} bytes)\n",
+        output.len()
     );
+    assert!(shown.starts_with(&format!("{marker}line 481\n")), "{shown}");
+    assert!(shown.ends_with("line 500\n"), "{shown}");
+    assert!(!shown.contains("line 480\n"), "{shown}");
+}
+
+#[test]
+fn stderr_dropped_by_the_reader_is_reported() {
+    let shown = excerpt("tail\n", 70_000);
+    assert_eq!(
+        shown,
+        "(truncated: showing the last 5 of 70000 bytes)\ntail\n"
+    );
+}
+
+#[test]
+fn single_huge_line_is_capped_by_bytes() {
+    let output = format!("<html>{}</html>", "é".repeat(100_000));
+    let shown = excerpt(&output, output.len());
+    assert!(shown.len() < STDERR_BYTES + 64, "{}", shown.len());
+    assert!(shown.ends_with("</html>\n"));
 }
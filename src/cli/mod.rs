@@ -5,6 +5,7 @@ mod compat;
 mod compat_support;
 mod dispatch;
 mod experimental;
+mod failure;
 mod gate_cmd;
 mod guard;
 mod help;
@@ -13,10 +14,12 @@ mod output;
 mod resolve;
 mod self_update;
 mod style;
+mod suggest;
 mod table;
 mod version;
 use crate::catalog;
 use args::Action;
+use failure::Failure;
 use std::path::Path;
 
 pub fn run<I>(args: I, catalog_root: &Path, home: &Path) -> i32
@@ -34,9 +37,9 @@ where
             }
             code
         }
-        Err(error) => {
-            eprint!("{}", style::error(&error));
-            2
+        Err(failure) => {
+            eprint!("{}{}", failure.detail, style::error(&failure.message));
+            failure.code
         }
     };
     style::restore(previous);
@@ -62,7 +65,7 @@ where
     (all, plain, no_color)
 }
 
-fn execute<I>(args: I, catalog_root: &Path, home: &Path) -> Result<(i32, String), String>
+fn execute<I>(args: I, catalog_root: &Path, home: &Path) -> Result<(i32, String), Failure>
 where
     I: IntoIterator,
     I::Item: Into<String>,
@@ -75,26 +78,13 @@ where
         return Ok((0, version::text(verbose, catalog_root, home)));
     }
     if let Action::SelfUpdate { dry_run } = action {
-        return self_update::run(dry_run);
+        return Ok(self_update::run(dry_run)?);
     }
     let harnesses =
-        catalog::load(catalog_root).map_err(|error| catalog_error(catalog_root, error))?;
+        catalog::load(catalog_root).map_err(|error| catalog::load_error(catalog_root, error))?;
     let errors = catalog::validate(&harnesses);
     if !errors.is_empty() {
-        return Err(errors.join("; "));
+        return Err(errors.join("; ").into());
     }
     dispatch::dispatch(action, &harnesses, catalog_root, home)
 }
-
-fn catalog_error(path: &Path, error: std::io::Error) -> String {
-    if error.kind() == std::io::ErrorKind::NotFound {
-        return format!(
-            "harness catalog is missing at {}; reinstall terminal-jarvis or set TERMINAL_JARVIS_CATALOG",
-            path.display()
-        );
-    }
-    format!(
-        "failed to load harness catalog at {}: {error}",
-        path.display()
-    )
-}
@@ -1,5 +1,7 @@
 #[path = "output_catalog.rs"]
 mod catalog;
+#[path = "output_next.rs"]
+mod next;
 #[path = "output_summary.rs"]
 mod summary;
 
@@ -8,6 +10,7 @@ use crate::contracts::Harness;
 use crate::{context::Session, security};
 
 pub use catalog::{list, plan, show};
+pub use next::readiness;
 pub use summary::{audit, status};
 
 pub fn help() -> String {
@@ -35,25 +38,37 @@ pub fn selected(name: &str) -> String {
     )
 }
 
+pub struct Probe {
+    pub installed: bool,
+    pub missing: Vec<String>,
+}
+
+pub fn probe(harnesses: &[Harness]) -> Vec<Probe> {
+    harnesses
+        .iter()
+        .map(|harness| Probe {
+            installed: security::command_on_path(&harness.binary),
+            missing: security::missing_env(harness),
+        })
+        .collect()
+}
+
 pub fn checks(harnesses: &[Harness]) -> String {
+    render_checks(harnesses, &probe(harnesses))
+}
+
+pub fn render_checks(harnesses: &[Harness], probes: &[Probe]) -> String {
+    let rows = harnesses.iter().zip(probes).map(|(harness, probe)| {
+        let binary = if probe.installed { "found" } else { "missing" };
+        let env = env_status(harness, &probe.missing);
+        vec![harness.name.clone(), binary.to_string(), env]
+    });
     if style::plain() {
-        return plain_checks(harnesses);
+        return rows
+            .map(|row| format!("{} binary={} env={}\n", row[0], row[1], row[2]))
+            .collect();
     }
-    let rows = harnesses
-        .iter()
-        .map(|harness| {
-            let binary = if security::command_on_path(&harness.binary) {
-                "found"
-            } else {
-                "missing"
-            };
-            vec![
-                harness.name.clone(),
-                binary.to_string(),
-                env_status(harness, &security::missing_env(harness)),
-            ]
-        })
-        .collect::<Vec<_>>();
+    let rows = rows.collect::<Vec<_>>();
     table::render(
         "Harness Readiness",
         &["HARNESS", "BINARY", "ENVIRONMENT"],
@@ -61,27 +76,13 @@ pub fn checks(harnesses: &[Harness]) -> String {
     )
 }
 
-fn plain_checks(harnesses: &[Harness]) -> String {
-    let mut out = String::new();
-    for harness in harnesses {
-        let binary = if security::command_on_path(&harness.binary) {
-            "found"
-        } else {
-            "missing"
-        };
-        let env = env_status(harness, &security::missing_env(harness));
-        out.push_str(&format!("{} binary={} env={}\n", harness.name, binary, env));
-    }
-    out
-}
-
 pub fn is_harness_ready(h: &Harness) -> bool {
     security::command_on_path(&h.binary) && security::missing_env(h).is_empty()
 }
 
 fn env_status(harness: &Harness, missing: &[String]) -> String {
     if missing.is_empty() {
-        return "ready".to_string();
+        return super::compat_support::ready_status(harness);
     }
     match harness.env_mode {
         crate::contracts::EnvMode::Any => format!("missing one of {}", missing.join(", ")),
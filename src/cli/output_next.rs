@@ -0,0 +1,89 @@
+use super::super::style;
+use super::{probe, render_checks};
+use crate::context;
+use crate::contracts::Harness;
+use std::path::Path;
+
+pub const SETUP_STEPS: usize = 3;
+
+pub struct Setup<'a> {
+    pub name: &'a str,
+    pub installed: bool,
+    pub authenticated: bool,
+    pub keyed: bool,
+}
+
+impl Setup<'_> {
+    fn ready(&self) -> bool {
+        self.installed && self.authenticated
+    }
+}
+
+pub fn readiness(harnesses: &[Harness], home: &Path) -> String {
+    let probes = probe(harnesses);
+    let table = render_checks(harnesses, &probes);
+    if style::plain() || tips_disabled() {
+        return table;
+    }
+    let setups = harnesses
+        .iter()
+        .zip(&probes)
+        .map(|(harness, probe)| Setup {
+            name: &harness.name,
+            installed: probe.installed,
+            authenticated: probe.missing.is_empty(),
+            keyed: !harness.env.is_empty() && probe.missing.is_empty(),
+        })
+        .collect::<Vec<_>>();
+    let session = context::load(home).ok().flatten();
+    let active = session.map(|session| session.active_harness);
+    let Some(step) = next_step(&setups, active.as_deref()) else {
+        return table;
+    };
+    let done = score(&setups, active.as_deref());
+    format!(
+        "{table}\n{} {step} (setup {done}/{SETUP_STEPS})\n",
+        style::label("Next:")
+    )
+}
+
+fn tips_disabled() -> bool {
+    std::env::var_os("TERMINAL_JARVIS_NO_TIPS").is_some_and(|value| !value.is_empty())
+}
+
+pub fn score(setups: &[Setup], active: Option<&str>) -> usize {
+    [
+        setups.iter().any(|setup| setup.installed),
+        setups.iter().any(Setup::ready),
+        setups
+            .iter()
+            .any(|setup| setup.ready() && Some(setup.name) == active),
+    ]
+    .into_iter()
+    .filter(|done| *done)
+    .count()
+}
+
+pub fn next_step(setups: &[Setup], active: Option<&str>) -> Option<String> {
+    setups.first()?;
+    let Some(installed) = setups.iter().find(|setup| setup.installed) else {
+        return Some(match setups.iter().find(|setup| setup.keyed) {
+            Some(setup) => format!("install {0} - terminal-jarvis install {0}", setup.name),
+            None => "pick a harness to install - terminal-jarvis list".to_string(),
+        });
+    };
+    let Some(ready) = setups.iter().find(|setup| setup.ready()) else {
+        let name = installed.name;
+        return Some(format!(
+            "authenticate {name} - terminal-jarvis auth help {name}"
+        ));
+    };
+    let active_ready = setups
+        .iter()
+        .any(|setup| setup.ready() && Some(setup.name) == active);
+    (!active_ready).then(|| format!("select {0} - terminal-jarvis use {0}", ready.name))
+}
+
+#[cfg(test)]
+#[path = "output_next_test.rs"]
+mod tests;
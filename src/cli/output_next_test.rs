@@ -0,0 +1,96 @@
+use super::*;
+
+fn setup(name: &str, installed: bool, authenticated: bool, keyed: bool) -> Setup<'_> {
+    Setup {
+        name,
+        installed,
+        authenticated,
+        keyed,
+    }
+}
+
+#[test]
+fn nothing_installed_suggests_a_harness_whose_key_is_set() {
+    let setups = [
+        setup("jules", false, true, false),
+        setup("claude", false, true, true),
+    ];
+    assert_eq!(
+        next_step(&setups, None).unwrap(),
+        "install claude - terminal-jarvis install claude"
+    );
+    assert_eq!(score(&setups, None), 0);
+}
+
+#[test]
+fn nothing_installed_or_keyed_points_to_the_list() {
+    let setups = [
+        setup("jules", false, true, false),
+        setup("aider", false, false, false),
+    ];
+    assert_eq!(
+        next_step(&setups, None).unwrap(),
+        "pick a harness to install - terminal-jarvis list"
+    );
+}
+
+#[test]
+fn installed_but_unauthenticated_suggests_auth_help() {
+    let setups = [
+        setup("aider", false, true, true),
+        setup("codex", true, false, false),
+    ];
+    assert_eq!(
+        next_step(&setups, None).unwrap(),
+        "authenticate codex - terminal-jarvis auth help codex"
+    );
+    assert_eq!(score(&setups, None), 1);
+}
+
+#[test]
+fn ready_harness_is_suggested_until_one_is_selected() {
+    let setups = [
+        setup("codex", true, false, false),
+        setup("claude", true, true, true),
+    ];
+    assert_eq!(
+        next_step(&setups, Some("codex")).unwrap(),
+        "select claude - terminal-jarvis use claude"
+    );
+    assert_eq!(score(&setups, Some("codex")), 2);
+    assert_eq!(next_step(&setups, Some("claude")), None);
+    assert_eq!(score(&setups, Some("claude")), SETUP_STEPS);
+}
+
+#[test]
+fn empty_catalog_has_no_next_step() {
+    assert_eq!(next_step(&[], None), None);
+    assert_eq!(score(&[], None), 0);
+}
+
+#[test]
+fn tips_env_opt_out_hides_the_next_step() {
+    let _guard = crate::ENV_LOCK
+        .lock()
+        .unwrap_or_else(|error| error.into_inner());
+    let harnesses = [Harness {
+        name: "ghost".into(),
+        display: "Ghost".into(),
+        description: String::new(),
+        binary: "tj-next-step-missing-binary".into(),
+        env_mode: crate::contracts::EnvMode::None,
+        env: vec![],
+        env_aliases: vec![],
+        capabilities: vec![],
+    }];
+    let home = Path::new("/nonexistent/terminal-jarvis-next-step");
+    let shown = readiness(&harnesses, home);
+    std::env::set_var("TERMINAL_JARVIS_NO_TIPS", "1");
+    let hidden = readiness(&harnesses, home);
+    std::env::remove_var("TERMINAL_JARVIS_NO_TIPS");
+    assert!(
+        shown.contains("terminal-jarvis list (setup 0/3)"),
+        "{shown}"
+    );
+    assert!(!hidden.contains("Next:"), "{hidden}");
+}
@@ -29,6 +29,7 @@ fn mock_harness(binary: &str, env_mode: EnvMode, env: Vec<String>) -> Harness {
         binary: binary.into(),
         env_mode,
         env,
+        env_aliases: vec![],
         capabilities: vec![],
     }
 }
@@ -1,3 +1,4 @@
+use super::suggest;
 use crate::context;
 use crate::contracts::{Capability, Harness};
 use std::path::Path;
@@ -47,7 +48,8 @@ pub fn direct(
 ) -> Result<Invocation, String> {
     if !has_harness(harnesses, harness) {
         return Err(format!(
-            "unknown command or harness '{harness}'; run `terminal-jarvis list`"
+            "unknown command or harness '{harness}'{}; run `terminal-jarvis list`",
+            suggest::hint(harness, harnesses)
         ));
     }
     Ok(invocation(
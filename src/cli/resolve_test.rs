@@ -27,6 +27,7 @@ fn harness(name: &str) -> Harness {
         binary: name.to_string(),
         env_mode: EnvMode::None,
         env: vec![],
+        env_aliases: vec![],
         capabilities: vec![],
     }
 }
@@ -80,3 +81,12 @@ fn explicit_harness_capability_is_preserved() {
     assert_eq!(inv.harness, "opencode");
     assert_eq!(inv.capability, Capability::Version);
 }
+
+#[test]
+fn misspelled_direct_harness_suggests_the_closest_name() {
+    let harnesses = vec![harness("claude"), harness("codex")];
+    let error = direct("claud", &[], &harnesses).err().unwrap();
+    assert!(error.contains("did you mean 'claude'?"), "{error}");
+    let error = direct("zed", &[], &harnesses).err().unwrap();
+    assert!(!error.contains("did you mean"), "{error}");
+}
@@ -40,7 +40,11 @@ pub fn warning(value: &str) -> String {
 }
 
 pub fn error(value: &str) -> String {
-    format!("{}\n", paint(&format!("error: {value}"), "1;31"))
+    let message = format!("error: {value}");
+    format!(
+        "{}\n",
+        paint_for(std::io::stderr().is_terminal(), &message, "1;31")
+    )
 }
 
 pub fn banner(title: &str, subtitle: &str) -> String {
@@ -51,11 +55,15 @@ pub fn banner(title: &str, subtitle: &str) -> String {
 }
 
 fn paint(value: &str, code: &str) -> String {
+    paint_for(std::io::stdout().is_terminal(), value, code)
+}
+
+fn paint_for(terminal: bool, value: &str, code: &str) -> String {
     let term = std::env::var("TERM").ok();
     if color_enabled_for(
-        std::io::stdout().is_terminal(),
+        terminal,
         OPTIONS.with(|cell| cell.get().no_color),
-        std::env::var_os("NO_COLOR").is_some(),
+        env_disables_color("NO_COLOR") || env_disables_color("TERMINAL_JARVIS_NO_COLOR"),
         term_is_dumb(term.as_deref()),
     ) {
         format!("\x1b[{code}m{value}\x1b[0m")
@@ -64,6 +72,10 @@ fn paint(value: &str, code: &str) -> String {
     }
 }
 
+fn env_disables_color(key: &str) -> bool {
+    std::env::var_os(key).is_some_and(|value| !value.is_empty())
+}
+
 fn term_is_dumb(term: Option<&str>) -> bool {
     term == Some("dumb")
 }
@@ -29,3 +29,25 @@ fn color_requires_every_enabling_condition() {
     assert!(!term_is_dumb(Some("xterm")));
     assert!(!term_is_dumb(None));
 }
+
+#[test]
+fn redirected_streams_are_never_painted() {
+    assert_eq!(paint_for(false, "error: boom", "1;31"), "error: boom");
+}
+
+#[test]
+fn only_non_empty_color_variables_disable_color() {
+    let _guard = crate::ENV_LOCK
+        .lock()
+        .unwrap_or_else(|error| error.into_inner());
+    let previous = std::env::var_os("TERMINAL_JARVIS_NO_COLOR");
+    std::env::set_var("TERMINAL_JARVIS_NO_COLOR", "");
+    assert!(!env_disables_color("TERMINAL_JARVIS_NO_COLOR"));
+    std::env::set_var("TERMINAL_JARVIS_NO_COLOR", "1");
+    assert!(env_disables_color("TERMINAL_JARVIS_NO_COLOR"));
+    std::env::remove_var("TERMINAL_JARVIS_NO_COLOR");
+    assert!(!env_disables_color("TERMINAL_JARVIS_NO_COLOR"));
+    if let Some(value) = previous {
+        std::env::set_var("TERMINAL_JARVIS_NO_COLOR", value);
+    }
+}
@@ -0,0 +1,83 @@
+use crate::contracts::Harness;
+
+const LIMIT: usize = 3;
+
+pub fn unknown(name: &str, harnesses: &[Harness]) -> String {
+    format!("unknown harness '{name}'{}", hint(name, harnesses))
+}
+
+pub fn hint(name: &str, harnesses: &[Harness]) -> String {
+    let aliases = harnesses.iter().flat_map(|harness| {
+        [
+            (harness.name.as_str(), harness.name.as_str()),
+            (harness.binary.as_str(), harness.name.as_str()),
+        ]
+    });
+    match closest(name, aliases).as_slice() {
+        [] => String::new(),
+        [only] => format!(" (did you mean '{only}'?)"),
+        many => format!(
+            " (did you mean one of {}?)",
+            many.iter()
+                .map(|name| format!("'{name}'"))
+                .collect::<Vec<_>>()
+                .join(", ")
+        ),
+    }
+}
+
+pub fn closest<'a, I>(target: &str, aliases: I) -> Vec<&'a str>
+where
+    I: IntoIterator<Item = (&'a str, &'a str)>,
+{
+    let target = target.to_ascii_lowercase();
+    let threshold = (target.chars().count() / 4).max(1);
+    let mut scored = aliases
+        .into_iter()
+        .map(|(alias, name)| (distance(&target, &alias.to_ascii_lowercase()), name))
+        .filter(|(score, _)| *score <= threshold)
+        .collect::<Vec<_>>();
+    scored.sort_by(|left, right| {
+        (left.0, left.1.len(), left.1).cmp(&(right.0, right.1.len(), right.1))
+    });
+    let exact = scored.first().is_some_and(|(score, _)| *score == 0);
+    let mut names = Vec::new();
+    for (score, name) in scored {
+        if exact && score > 0 {
+            break;
+        }
+        if !names.contains(&name) && names.len() < LIMIT {
+            names.push(name);
+        }
+    }
+    names
+}
+
+pub fn distance(left: &str, right: &str) -> usize {
+    let left = left.chars().collect::<Vec<_>>();
+    let right = right.chars().collect::<Vec<_>>();
+    let mut table = vec![vec![0; right.len() + 1]; left.len() + 1];
+    table[0] = (0..=right.len()).collect();
+    for row in 1..=left.len() {
+        table[row][0] = row;
+        for column in 1..=right.len() {
+            let cost = usize::from(left[row - 1] != right[column - 1]);
+            let mut best = (table[row - 1][column] + 1)
+                .min(table[row][column - 1] + 1)
+                .min(table[row - 1][column - 1] + cost);
+            let swapped = row > 1
+                && column > 1
+                && left[row - 1] == right[column - 2]
+                && left[row - 2] == right[column - 1];
+            if swapped {
+                best = best.min(table[row - 2][column - 2] + 1);
+            }
+            table[row][column] = best;
+        }
+    }
+    table[left.len()][right.len()]
+}
+
+#[cfg(test)]
+#[path = "suggest_test.rs"]
+mod tests;
@@ -0,0 +1,92 @@
+use super::*;
+use crate::contracts::EnvMode;
+
+const NAMES: [&str; 6] = ["aider", "claude", "code", "codex", "gemini", "opencode"];
+
+fn aliases() -> impl Iterator<Item = (&'static str, &'static str)> {
+    NAMES.into_iter().map(|name| (name, name))
+}
+
+fn harness(name: &str) -> Harness {
+    Harness {
+        name: name.to_string(),
+        display: name.to_string(),
+        description: String::new(),
+        binary: name.to_string(),
+        env_mode: EnvMode::None,
+        env: vec![],
+        env_aliases: vec![],
+        capabilities: vec![],
+    }
+}
+
+#[test]
+fn distance_counts_single_character_edits() {
+    assert_eq!(distance("claude", "claude"), 0);
+    assert_eq!(distance("claud", "claude"), 1);
+    assert_eq!(distance("gemeni", "gemini"), 1);
+    assert_eq!(distance("", "aider"), 5);
+    assert_eq!(distance("kitten", "sitting"), 3);
+}
+
+#[test]
+fn transposed_letters_count_as_one_edit() {
+    assert_eq!(distance("cluade", "claude"), 1);
+    assert_eq!(distance("gemnii", "gemini"), 1);
+    assert_eq!(closest("cluade", aliases()), ["claude"]);
+    assert_eq!(closest("gemni", aliases()), ["gemini"]);
+}
+
+#[test]
+fn binary_aliases_resolve_to_the_harness_name() {
+    let mut code = harness("code");
+    code.binary = "coder".to_string();
+    assert_eq!(
+        hint("coder", &[code, harness("codex")]),
+        " (did you mean 'code'?)"
+    );
+    assert_eq!(
+        closest("codr", [("coder", "code"), ("code", "code")]),
+        ["code"]
+    );
+}
+
+#[test]
+fn common_typos_suggest_the_intended_harness() {
+    assert_eq!(closest("claud", aliases()), ["claude"]);
+    assert_eq!(closest("gemin", aliases()), ["gemini"]);
+    assert_eq!(closest("opencod", aliases()), ["opencode"]);
+    assert_eq!(closest("Aidr", aliases()), ["aider"]);
+}
+
+#[test]
+fn ties_prefer_the_shorter_name() {
+    assert_eq!(closest("codes", aliases()), ["code", "codex"]);
+}
+
+#[test]
+fn distant_names_are_not_suggested() {
+    assert!(closest("zed", aliases()).is_empty());
+    assert!(closest("launch", aliases()).is_empty());
+}
+
+#[test]
+fn hint_lists_every_close_match() {
+    let harnesses = NAMES.map(harness);
+    assert_eq!(hint("claud", &harnesses), " (did you mean 'claude'?)");
+    assert_eq!(
+        hint("codes", &harnesses),
+        " (did you mean one of 'code', 'codex'?)"
+    );
+    assert_eq!(hint("zed", &harnesses), "");
+}
+
+#[test]
+fn unknown_harness_errors_carry_the_hint() {
+    let harnesses = NAMES.map(harness);
+    assert_eq!(
+        unknown("claud", &harnesses),
+        "unknown harness 'claud' (did you mean 'claude'?)"
+    );
+    assert_eq!(unknown("ghost", &harnesses), "unknown harness 'ghost'");
+}
@@ -15,6 +15,7 @@ pub struct Harness {
     pub binary: String,
     pub env_mode: EnvMode,
     pub env: Vec<String>,
+    pub env_aliases: Vec<String>,
     pub capabilities: Vec<CapabilityPlan>,
 }
 
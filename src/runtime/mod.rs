@@ -2,4 +2,4 @@ mod agent_loop;
 mod runner;
 
 pub use agent_loop::{next_step, planned_steps};
-pub use runner::run_command;
+pub use runner::{run_command, Exit};
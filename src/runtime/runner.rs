@@ -1,18 +1,75 @@
 use crate::contracts::CapabilityPlan;
-use std::io;
-use std::process::{Command, Stdio};
+use std::io::{self, Read};
+use std::process::{Command, ExitStatus, Stdio};
 
-pub fn run_command(plan: &CapabilityPlan, extra: &[String]) -> io::Result<(i32, String)> {
+const STDERR_CAP: usize = 64 * 1024;
+
+pub struct Exit {
+    pub code: i32,
+    pub stderr: String,
+    pub stderr_len: usize,
+}
+
+pub fn run_command(plan: &CapabilityPlan, extra: &[String]) -> io::Result<Exit> {
     let mut command = Command::new(&plan.command.command);
     command.args(&plan.command.args).args(extra);
+    command.stdin(Stdio::inherit());
     command.stdout(Stdio::inherit());
     command.stderr(Stdio::piped());
-    let output = command.output()?;
-    let code = output.status.code().unwrap_or(1);
-    let stderr = String::from_utf8_lossy(&output.stderr).to_string();
+    let mut child = command.spawn()?;
+    let tail = match child.stderr.take() {
+        Some(pipe) => read_tail(pipe, STDERR_CAP),
+        None => Ok((Vec::new(), 0)),
+    };
+    let code = exit_code(child.wait()?);
+    let (stderr, stderr_len) = tail?;
     if code == 0 {
-        Ok((0, String::new()))
-    } else {
-        Ok((code, stderr))
+        return Ok(Exit {
+            code,
+            stderr: String::new(),
+            stderr_len: 0,
+        });
+    }
+    Ok(Exit {
+        code,
+        stderr: String::from_utf8_lossy(&stderr).into_owned(),
+        stderr_len,
+    })
+}
+
+fn read_tail(mut reader: impl Read, cap: usize) -> io::Result<(Vec<u8>, usize)> {
+    let mut kept = Vec::new();
+    let mut chunk = [0; 8192];
+    let mut total = 0;
+    loop {
+        let read = match reader.read(&mut chunk) {
+            Ok(0) => return Ok((kept, total)),
+            Ok(read) => read,
+            Err(error) if error.kind() == io::ErrorKind::Interrupted => continue,
+            Err(error) => return Err(error),
+        };
+        total += read;
+        kept.extend_from_slice(&chunk[..read]);
+        if kept.len() > cap {
+            kept.drain(..kept.len() - cap);
+        }
     }
 }
+
+#[cfg(unix)]
+fn exit_code(status: ExitStatus) -> i32 {
+    use std::os::unix::process::ExitStatusExt;
+    status
+        .code()
+        .or_else(|| status.signal().map(|signal| 128 + signal))
+        .unwrap_or(1)
+}
+
+#[cfg(not(unix))]
+fn exit_code(status: ExitStatus) -> i32 {
+    status.code().unwrap_or(1)
+}
+
+#[cfg(test)]
+#[path = "runner_test.rs"]
+mod tests;
@@ -0,0 +1,17 @@
+use super::*;
+
+#[test]
+fn short_stderr_is_kept_whole() {
+    let (kept, total) = read_tail(&b"boom\n"[..], STDERR_CAP).unwrap();
+    assert_eq!(kept, b"boom\n");
+    assert_eq!(total, 5);
+}
+
+#[test]
+fn huge_stderr_keeps_only_the_capped_tail() {
+    let body = io::repeat(b'x').take(5_000_000).chain(&b"end\n"[..]);
+    let (kept, total) = read_tail(body, STDERR_CAP).unwrap();
+    assert_eq!(kept.len(), STDERR_CAP);
+    assert!(kept.ends_with(b"xend\n"));
+    assert_eq!(total, 5_000_004);
+}
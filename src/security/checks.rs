@@ -53,6 +53,18 @@ pub fn missing_env(harness: &Harness) -> Vec<String> {
     }
 }
 
+pub fn conflicting_env(harness: &Harness) -> Vec<String> {
+    let set = harness
+        .env_aliases
+        .iter()
+        .filter_map(|name| Some((name, env::var_os(name).filter(|v| !v.is_empty())?)))
+        .collect::<Vec<_>>();
+    if set.iter().all(|(_, value)| *value == set[0].1) {
+        return Vec::new();
+    }
+    set.into_iter().map(|(name, _)| name.clone()).collect()
+}
+
 #[cfg(test)]
 mod tests {
     use super::candidates;
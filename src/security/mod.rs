@@ -1,3 +1,3 @@
 mod checks;
 
-pub use checks::{command_on_path, missing_env};
+pub use checks::{command_on_path, conflicting_env, missing_env};
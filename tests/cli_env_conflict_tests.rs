@@ -0,0 +1,78 @@
+use std::process::{Command, Output};
+
+fn tj(args: &[&str], vars: &[(&str, &str)]) -> Output {
+    Command::new(env!("CARGO_BIN_EXE_terminal-jarvis"))
+        .arg("--plain")
+        .args(args)
+        .env_clear()
+        .env("PATH", std::env::var_os("PATH").unwrap_or_default())
+        .env(
+            "TERMINAL_JARVIS_HOME",
+            std::env::temp_dir().join(format!("terminal-jarvis-conflict-{}", std::process::id())),
+        )
+        .envs(vars.iter().copied())
+        .output()
+        .expect("terminal-jarvis runs")
+}
+
+fn line(output: &Output, prefix: &str) -> String {
+    String::from_utf8_lossy(&output.stdout)
+        .lines()
+        .find(|line| line.starts_with(prefix))
+        .unwrap_or_default()
+        .to_string()
+}
+
+#[test]
+fn check_flags_differing_gemini_keys_without_printing_them() {
+    let vars = [
+        ("GOOGLE_API_KEY", "stale-key"),
+        ("GEMINI_API_KEY", "fresh-key"),
+    ];
+    let output = tj(&["check"], &vars);
+    for harness in ["gemini ", "goose ", "hermes "] {
+        let line = line(&output, harness);
+        assert!(
+            line.ends_with(
+                "env=ready, but GOOGLE_API_KEY, GEMINI_API_KEY differ; unset the stale one"
+            ),
+            "{line}"
+        );
+        assert!(!line.contains("-key"), "{line}");
+    }
+}
+
+#[test]
+fn check_reports_matching_gemini_keys_as_ready() {
+    let vars = [
+        ("GOOGLE_API_KEY", "same-key"),
+        ("GEMINI_API_KEY", "same-key"),
+    ];
+    let line = line(&tj(&["check"], &vars), "gemini ");
+    assert!(line.ends_with("env=ready"), "{line}");
+}
+
+#[test]
+fn different_provider_keys_are_not_conflicts() {
+    let vars = [
+        ("ANTHROPIC_API_KEY", "anthropic"),
+        ("OPENAI_API_KEY", "openai"),
+    ];
+    let output = tj(&["check"], &vars);
+    assert!(line(&output, "aider ").ends_with("env=ready"));
+    assert!(line(&output, "goose ").ends_with("env=ready"));
+    assert!(!String::from_utf8_lossy(&output.stdout).contains("differ"));
+}
+
+#[test]
+fn auth_help_flags_differing_gemini_keys() {
+    let vars = [
+        ("GOOGLE_API_KEY", "stale-key"),
+        ("GEMINI_API_KEY", "fresh-key"),
+    ];
+    let line = line(&tj(&["auth", "help", "gemini"], &vars), "status:");
+    assert!(
+        line.contains("GOOGLE_API_KEY, GEMINI_API_KEY differ"),
+        "{line}"
+    );
+}
@@ -0,0 +1,58 @@
+#[cfg(unix)]
+mod unix {
+    use std::fs;
+    use std::process::{Command, Output};
+    use std::sync::atomic::{AtomicUsize, Ordering};
+
+    static TEMP_ID: AtomicUsize = AtomicUsize::new(0);
+
+    fn temp_dir() -> std::path::PathBuf {
+        std::env::temp_dir().join(format!(
+            "terminal-jarvis-exit-{}-{}",
+            std::process::id(),
+            TEMP_ID.fetch_add(1, Ordering::Relaxed)
+        ))
+    }
+
+    fn launch(script: &str) -> Output {
+        use std::os::unix::fs::PermissionsExt;
+
+        let dir = temp_dir().join("bin");
+        fs::create_dir_all(&dir).unwrap();
+        let path = dir.join("codex");
+        fs::write(&path, format!("#!/usr/bin/env sh\n{script}\n")).unwrap();
+        let mut permissions = fs::metadata(&path).unwrap().permissions();
+        permissions.set_mode(0o755);
+        fs::set_permissions(&path, permissions).unwrap();
+        let old_path = std::env::var("PATH").unwrap_or_default();
+        Command::new(env!("CARGO_BIN_EXE_terminal-jarvis"))
+            .args(["--plain", "codex"])
+            .env("TERMINAL_JARVIS_HOME", temp_dir())
+            .env("PATH", format!("{}:{old_path}", dir.to_string_lossy()))
+            .output()
+            .expect("terminal-jarvis runs")
+    }
+
+    #[test]
+    fn successful_child_exits_zero_with_only_its_output() {
+        let output = launch("echo launched");
+        assert_eq!(output.status.code(), Some(0));
+        assert_eq!(String::from_utf8_lossy(&output.stdout), "launched\n");
+    }
+
+    #[test]
+    fn failing_child_exit_code_is_propagated() {
+        let output = launch("echo partial; exit 1");
+        assert_eq!(output.status.code(), Some(1));
+        assert_eq!(String::from_utf8_lossy(&output.stdout), "partial\n");
+        assert_eq!(launch("exit 42").status.code(), Some(42));
+    }
+
+    #[test]
+    fn signalled_child_maps_to_shell_convention() {
+        let output = launch("kill -TERM $$");
+        assert_eq!(output.status.code(), Some(128 + 15));
+        assert!(output.stdout.is_empty());
+        assert!(String::from_utf8_lossy(&output.stderr).contains("failed with exit 143"));
+    }
+}
@@ -0,0 +1,67 @@
+#[cfg(unix)]
+mod unix {
+    use std::fs;
+    use std::io::Write;
+    use std::process::{Command, Output, Stdio};
+    use std::sync::atomic::{AtomicUsize, Ordering};
+
+    static TEMP_ID: AtomicUsize = AtomicUsize::new(0);
+
+    fn temp_dir() -> std::path::PathBuf {
+        std::env::temp_dir().join(format!(
+            "terminal-jarvis-stdin-{}-{}",
+            std::process::id(),
+            TEMP_ID.fetch_add(1, Ordering::Relaxed)
+        ))
+    }
+
+    fn fake_bin(name: &str, script: &str) -> String {
+        use std::os::unix::fs::PermissionsExt;
+
+        let dir = temp_dir().join("bin");
+        fs::create_dir_all(&dir).unwrap();
+        let path = dir.join(name);
+        fs::write(&path, format!("#!/usr/bin/env sh\n{script}\n")).unwrap();
+        let mut permissions = fs::metadata(&path).unwrap().permissions();
+        permissions.set_mode(0o755);
+        fs::set_permissions(&path, permissions).unwrap();
+        let old_path = std::env::var("PATH").unwrap_or_default();
+        format!("{}:{old_path}", dir.to_string_lossy())
+    }
+
+    fn tj_with_stdin(args: &[&str], path: &str, input: &str) -> Output {
+        let mut child = Command::new(env!("CARGO_BIN_EXE_terminal-jarvis"))
+            .arg("--plain")
+            .args(args)
+            .env("TERMINAL_JARVIS_HOME", temp_dir())
+            .env("PATH", path)
+            .stdin(Stdio::piped())
+            .stdout(Stdio::piped())
+            .stderr(Stdio::piped())
+            .spawn()
+            .expect("terminal-jarvis runs");
+        child
+            .stdin
+            .take()
+            .unwrap()
+            .write_all(input.as_bytes())
+            .unwrap();
+        child.wait_with_output().expect("terminal-jarvis exits")
+    }
+
+    #[test]
+    fn piped_stdin_reaches_the_harness_command() {
+        let path = fake_bin("opencode", "cat");
+        let output = tj_with_stdin(&["run", "opencode"], &path, "fix the tests\n");
+        assert!(output.status.success(), "{output:?}");
+        assert_eq!(String::from_utf8_lossy(&output.stdout), "fix the tests\n");
+    }
+
+    #[test]
+    fn piped_stdin_reaches_direct_launches() {
+        let path = fake_bin("codex", "read line; printf 'got %s\\n' \"$line\"");
+        let output = tj_with_stdin(&["codex"], &path, "summarize\n");
+        assert!(output.status.success(), "{output:?}");
+        assert_eq!(String::from_utf8_lossy(&output.stdout), "got summarize\n");
+    }
+}
@@ -17,6 +17,7 @@ fn harness(name: &str, mode: EnvMode, env: Vec<String>) -> Harness {
         binary: "sh".to_string(),
         env_mode: mode,
         env,
+        env_aliases: vec![],
         capabilities: Capability::ALL
             .iter()
             .map(|capability| plan(*capability, "Dangerous test plan", "sh"))
@@ -33,6 +34,7 @@ fn validation_reports_contract_errors() {
         binary: String::new(),
         env_mode: EnvMode::None,
         env: vec!["bad-env".to_string()],
+        env_aliases: vec!["OTHER_KEY".to_string()],
         capabilities: vec![
             plan(Capability::Update, "update", "login"),
             plan(Capability::Yolo, "fast mode", "sh"),
@@ -43,6 +45,7 @@ fn validation_reports_contract_errors() {
     assert!(errors.contains("empty binary"));
     assert!(errors.contains("env vars with env_mode none"));
     assert!(errors.contains("invalid env"));
+    assert!(errors.contains("aliases OTHER_KEY, which is not in env"));
     assert!(errors.contains("missing a core capability"));
     assert!(errors.contains("update command looks interactive"));
     assert!(errors.contains("yolo summary must mention danger"));